@@ -0,0 +1,173 @@
+package runscope
+
+import (
+	"fmt"
+
+	"github.com/ewilde/go-runscope"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceRunscopeEnvironment() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceEnvironmentRead,
+
+		Schema: map[string]*schema.Schema{
+			"bucket_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"test_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"script": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"preserve_cookies": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"initial_variables": &schema.Schema{
+				Type:     schema.TypeMap,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Computed: true,
+			},
+			"sensitive_variable_keys": &schema.Schema{
+				Type:     schema.TypeSet,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Optional: true,
+			},
+			"sensitive_initial_variables": &schema.Schema{
+				Type:      schema.TypeMap,
+				Elem:      &schema.Schema{Type: schema.TypeString},
+				Computed:  true,
+				Sensitive: true,
+			},
+			"integrations": &schema.Schema{
+				Type:     schema.TypeSet,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Computed: true,
+			},
+			"retry_on_failure": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"verify_ssl": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"webhooks": &schema.Schema{
+				Type:     schema.TypeSet,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Computed: true,
+			},
+			"emails": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"notify_all": &schema.Schema{
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"notify_on": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"notify_threshold": &schema.Schema{
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"recipients": &schema.Schema{
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": &schema.Schema{
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"id": &schema.Schema{
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"email": &schema.Schema{
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+							Set: recipientsHash,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceEnvironmentRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*runscope.Client)
+
+	bucketID := d.Get("bucket_id").(string)
+	name := d.Get("name").(string)
+
+	var environments []*runscope.Environment
+	var err error
+	if testID, ok := d.GetOk("test_id"); ok {
+		environments, err = client.ReadTestEnvironments(
+			&runscope.Test{ID: testID.(string), Bucket: &runscope.Bucket{Key: bucketID}})
+	} else {
+		environments, err = client.ReadEnvironments(&runscope.Bucket{Key: bucketID})
+	}
+
+	if err != nil {
+		return fmt.Errorf("Couldn't read environments for bucket %s: %s", bucketID, err)
+	}
+
+	var matches []*runscope.Environment
+	for _, environment := range environments {
+		if environment.Name == name {
+			matches = append(matches, environment)
+		}
+	}
+
+	if len(matches) == 0 {
+		return fmt.Errorf("No environment found with name: %s", name)
+	}
+
+	if len(matches) > 1 {
+		return fmt.Errorf("Found %d environments matching name: %s", len(matches), name)
+	}
+
+	found := matches[0]
+	d.SetId(found.ID)
+	d.Set("bucket_id", bucketID)
+	d.Set("name", found.Name)
+	d.Set("script", found.Script)
+	d.Set("preserve_cookies", found.PreserveCookies)
+
+	declaredSensitive := map[string]interface{}{}
+	if attr, ok := d.GetOk("sensitive_variable_keys"); ok {
+		for _, key := range attr.(*schema.Set).List() {
+			declaredSensitive[key.(string)] = true
+		}
+	}
+
+	nonSensitiveVariables, sensitiveVariables := splitSensitiveVariables(found.InitialVariables, declaredSensitive)
+	d.Set("initial_variables", nonSensitiveVariables)
+	d.Set("sensitive_initial_variables", sensitiveVariables)
+
+	d.Set("integrations", readIntegrations(found.Integrations))
+	d.Set("retry_on_failure", found.RetryOnFailure)
+	d.Set("verify_ssl", found.VerifySsl)
+	d.Set("webhooks", found.WebHooks)
+	d.Set("emails", readEmail(found.EmailSettings))
+
+	return nil
+}
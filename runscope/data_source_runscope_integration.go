@@ -0,0 +1,75 @@
+package runscope
+
+import (
+	"fmt"
+
+	"github.com/ewilde/go-runscope"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceRunscopeIntegration() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceIntegrationRead,
+
+		Schema: map[string]*schema.Schema{
+			"team_uuid": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"type": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"uuid": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceIntegrationRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*runscope.Client)
+
+	teamUUID := d.Get("team_uuid").(string)
+	integrationType := d.Get("type").(string)
+	description, hasDescription := d.GetOk("description")
+
+	integrations, err := client.ReadIntegrations(&runscope.Team{UUID: teamUUID})
+	if err != nil {
+		return fmt.Errorf("Couldn't read integrations for team %s: %s", teamUUID, err)
+	}
+
+	var matches []*runscope.EnvironmentIntegration
+	for _, integration := range integrations {
+		if integration.IntegrationType != integrationType {
+			continue
+		}
+
+		if hasDescription && integration.Description != description.(string) {
+			continue
+		}
+
+		matches = append(matches, integration)
+	}
+
+	if len(matches) == 0 {
+		return fmt.Errorf("No integration found with type: %s", integrationType)
+	}
+
+	if len(matches) > 1 {
+		return fmt.Errorf("Found %d integrations matching type: %s, use description to narrow the result", len(matches), integrationType)
+	}
+
+	integration := matches[0]
+	d.SetId(integration.ID)
+	d.Set("uuid", integration.ID)
+	d.Set("description", integration.Description)
+
+	return nil
+}
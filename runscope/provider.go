@@ -0,0 +1,40 @@
+package runscope
+
+import (
+	"github.com/ewilde/go-runscope"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// Provider returns a terraform.ResourceProvider for Runscope.
+func Provider() terraform.ResourceProvider {
+	return &schema.Provider{
+		Schema: map[string]*schema.Schema{
+			"access_token": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				DefaultFunc: schema.EnvDefaultFunc("RUNSCOPE_ACCESS_TOKEN", nil),
+			},
+			"api_url": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("RUNSCOPE_API_URL", runscope.DefaultBaseURL),
+			},
+		},
+
+		ResourcesMap: map[string]*schema.Resource{
+			"runscope_environment": resourceRunscopeEnvironment(),
+		},
+
+		DataSourcesMap: map[string]*schema.Resource{
+			"runscope_environment": dataSourceRunscopeEnvironment(),
+			"runscope_integration": dataSourceRunscopeIntegration(),
+		},
+
+		ConfigureFunc: providerConfigure,
+	}
+}
+
+func providerConfigure(d *schema.ResourceData) (interface{}, error) {
+	return runscope.NewClient(d.Get("api_url").(string), d.Get("access_token").(string)), nil
+}
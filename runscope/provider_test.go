@@ -0,0 +1,47 @@
+package runscope
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+var testAccProviders map[string]terraform.ResourceProvider
+var testAccProvider *schema.Provider
+
+func init() {
+	testAccProvider = Provider().(*schema.Provider)
+	testAccProviders = map[string]terraform.ResourceProvider{
+		"runscope": testAccProvider,
+	}
+}
+
+func testAccPreCheck(t *testing.T) {
+	if v := os.Getenv("RUNSCOPE_ACCESS_TOKEN"); v == "" {
+		t.Fatal("RUNSCOPE_ACCESS_TOKEN must be set for acceptance tests")
+	}
+
+	if v := os.Getenv("RUNSCOPE_TEAM_ID"); v == "" {
+		t.Fatal("RUNSCOPE_TEAM_ID must be set for acceptance tests")
+	}
+}
+
+func TestProvider(t *testing.T) {
+	if err := Provider().(*schema.Provider).InternalValidate(); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+}
+
+// TestProvider_dataSourcesRegistered guards against the data sources
+// regressing into the kind of dead code they started as: declared but never
+// wired into the provider's DataSourcesMap, so no HCL could ever reach them.
+func TestProvider_dataSourcesRegistered(t *testing.T) {
+	provider := Provider().(*schema.Provider)
+	for _, name := range []string{"runscope_environment", "runscope_integration"} {
+		if _, ok := provider.DataSourcesMap[name]; !ok {
+			t.Errorf("expected data source %q to be registered in DataSourcesMap", name)
+		}
+	}
+}
@@ -5,12 +5,14 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/hashcode"
 
 	"github.com/ewilde/go-runscope"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/unya/terraform-provider-runscope/runscope/waiter"
 )
 
 func resourceRunscopeEnvironment() *schema.Resource {
@@ -19,6 +21,9 @@ func resourceRunscopeEnvironment() *schema.Resource {
 		Read:   resourceEnvironmentRead,
 		Update: resourceEnvironmentUpdate,
 		Delete: resourceEnvironmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceEnvironmentImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"bucket_id": &schema.Schema{
@@ -52,6 +57,13 @@ func resourceRunscopeEnvironment() *schema.Resource {
 				Optional: true,
 				ForceNew: false,
 			},
+			"sensitive_initial_variables": &schema.Schema{
+				Type:      schema.TypeMap,
+				Elem:      &schema.Schema{Type: schema.TypeString},
+				Optional:  true,
+				ForceNew:  false,
+				Sensitive: true,
+			},
 			"integrations": &schema.Schema{
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -78,6 +90,16 @@ func resourceRunscopeEnvironment() *schema.Resource {
 				},
 				Optional: true,
 			},
+			"wait_for_remote_agents": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"remote_agent_wait_timeout": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "5m",
+			},
 			"retry_on_failure": &schema.Schema{
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -171,6 +193,36 @@ func resourceEnvironmentCreate(d *schema.ResourceData, meta interface{}) error {
 	d.SetId(createdEnvironment.ID)
 	log.Printf("[INFO] environment ID: %s", d.Id())
 
+	if len(environment.RemoteAgents) > 0 && d.Get("wait_for_remote_agents").(bool) {
+		timeout, err := time.ParseDuration(d.Get("remote_agent_wait_timeout").(string))
+		if err != nil {
+			return fmt.Errorf("Invalid remote_agent_wait_timeout: %s", err)
+		}
+
+		bucket, err := client.ReadBucket(&runscope.Bucket{Key: bucketID})
+		if err != nil {
+			return fmt.Errorf("Failed to read bucket %s while waiting for remote agents: %s", bucketID, err)
+		}
+		if bucket.Team == nil {
+			return fmt.Errorf("Bucket %s has no owning team; remote agents cannot be listed", bucketID)
+		}
+
+		uuids := make([]string, len(environment.RemoteAgents))
+		for i, remoteAgent := range environment.RemoteAgents {
+			uuids[i] = remoteAgent.UUID
+		}
+
+		delay := 10 * time.Second
+		if delay > timeout/2 {
+			delay = timeout / 2
+		}
+
+		log.Printf("[INFO] Waiting for remote agents to connect: %v", uuids)
+		if err := waiter.RemoteAgentsConnected(client, bucket.Team.UUID, uuids, timeout, delay, 5*time.Second); err != nil {
+			return err
+		}
+	}
+
 	return resourceEnvironmentRead(d, meta)
 }
 
@@ -206,7 +258,12 @@ func resourceEnvironmentRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("name", environment.Name)
 	d.Set("script", environment.Script)
 	d.Set("preserve_cookies", environment.PreserveCookies)
-	d.Set("initial_variables", environment.InitialVariables)
+
+	nonSensitiveVariables, sensitiveVariables := splitSensitiveVariables(
+		environment.InitialVariables, d.Get("sensitive_initial_variables").(map[string]interface{}))
+	d.Set("initial_variables", nonSensitiveVariables)
+	d.Set("sensitive_initial_variables", sensitiveVariables)
+
 	d.Set("integrations", readIntegrations(environment.Integrations))
 	d.Set("retry_on_failure", environment.RetryOnFailure)
 	d.Set("verify_ssl", environment.VerifySsl)
@@ -226,6 +283,7 @@ func resourceEnvironmentUpdate(d *schema.ResourceData, meta interface{}) error {
 		d.HasChange("script") ||
 		d.HasChange("preserve_cookies") ||
 		d.HasChange("initial_variables") ||
+		d.HasChange("sensitive_initial_variables") ||
 		d.HasChange("integrations") ||
 		d.HasChange("regions") ||
 		d.HasChange("remote_agents") ||
@@ -278,6 +336,29 @@ func resourceEnvironmentDelete(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
+// resourceEnvironmentImport supports `terraform import` of both shared and
+// test-scoped environments via a composite ID:
+//   bucket_id/environment_id           - shared environment
+//   bucket_id/test_id/environment_id   - test environment
+func resourceEnvironmentImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+
+	switch len(parts) {
+	case 2:
+		d.Set("bucket_id", parts[0])
+		d.SetId(parts[1])
+	case 3:
+		d.Set("bucket_id", parts[0])
+		d.Set("test_id", parts[1])
+		d.SetId(parts[2])
+	default:
+		return nil, fmt.Errorf(
+			"Invalid id %q, expected bucket_id/environment_id or bucket_id/test_id/environment_id", d.Id())
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func createEnvironmentFromResourceData(d *schema.ResourceData) (*runscope.Environment, error) {
 
 	environment := runscope.NewEnvironment()
@@ -299,13 +380,25 @@ func createEnvironmentFromResourceData(d *schema.ResourceData) (*runscope.Enviro
 		environment.PreserveCookies = attr.(bool)
 	}
 
+	variables := map[string]string{}
 	if attr, ok := d.GetOk("initial_variables"); ok {
-		variablesRaw := attr.(map[string]interface{})
-		variables := map[string]string{}
-		for k, v := range variablesRaw {
+		for k, v := range attr.(map[string]interface{}) {
 			variables[k] = v.(string)
 		}
+	}
+
+	if attr, ok := d.GetOk("sensitive_initial_variables"); ok {
+		for k, v := range attr.(map[string]interface{}) {
+			if _, exists := variables[k]; exists {
+				return nil, fmt.Errorf(
+					"key %q cannot appear in both initial_variables and sensitive_initial_variables", k)
+			}
 
+			variables[k] = v.(string)
+		}
+	}
+
+	if len(variables) > 0 {
 		environment.InitialVariables = variables
 	}
 
@@ -397,6 +490,27 @@ func createEnvironmentFromResourceData(d *schema.ResourceData) (*runscope.Enviro
 	return environment, nil
 }
 
+// splitSensitiveVariables divides the variables returned by the API between
+// the non-sensitive and sensitive maps: a variable goes to the sensitive map
+// when its key is in declaredSensitive (the keys of sensitive_initial_variables
+// for the resource, the sensitive_variable_keys set for the data source), so
+// that sensitive values written back to state never end up in initial_variables.
+func splitSensitiveVariables(
+	variables map[string]string, declaredSensitive map[string]interface{}) (map[string]string, map[string]string) {
+
+	nonSensitive := map[string]string{}
+	sensitive := map[string]string{}
+	for k, v := range variables {
+		if _, ok := declaredSensitive[k]; ok {
+			sensitive[k] = v
+		} else {
+			nonSensitive[k] = v
+		}
+	}
+
+	return nonSensitive, sensitive
+}
+
 func readIntegrations(integrations []*runscope.EnvironmentIntegration) []map[string]interface{} {
 	result := make([]map[string]interface{}, 0, len(integrations))
 	for _, integration := range integrations {
@@ -0,0 +1,163 @@
+package runscope
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/ewilde/go-runscope"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccEnvironment_importBasic(t *testing.T) {
+	resourceName := "runscope_environment.environment1"
+
+	bucket := testAccCreateBucketFixture(t)
+	defer testAccDeleteBucketFixture(t, bucket)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckEnvironmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvironmentConfigA(bucket.Key),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccEnvironmentImportStateIDFunc(resourceName),
+			},
+		},
+	})
+}
+
+func TestAccEnvironment_importTestEnvironment(t *testing.T) {
+	resourceName := "runscope_environment.environment1"
+
+	bucket := testAccCreateBucketFixture(t)
+	defer testAccDeleteBucketFixture(t, bucket)
+
+	test := testAccCreateTestFixture(t, bucket)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckEnvironmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTestEnvironmentConfig(bucket.Key, test.ID),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccTestEnvironmentImportStateIDFunc(resourceName),
+			},
+		},
+	})
+}
+
+func testAccEnvironmentImportStateIDFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["bucket_id"], rs.Primary.ID), nil
+	}
+}
+
+func testAccTestEnvironmentImportStateIDFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s/%s/%s",
+			rs.Primary.Attributes["bucket_id"], rs.Primary.Attributes["test_id"], rs.Primary.ID), nil
+	}
+}
+
+func testAccCheckEnvironmentDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*runscope.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "runscope_environment" {
+			continue
+		}
+
+		environment := &runscope.Environment{ID: rs.Primary.ID}
+		bucket := &runscope.Bucket{Key: rs.Primary.Attributes["bucket_id"]}
+		_, err := client.ReadSharedEnvironment(environment, bucket)
+		if err == nil {
+			return fmt.Errorf("Environment with id %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+// testAccRunscopeClient builds a client directly from the acceptance test
+// environment variables, independent of the provider under test, so fixtures
+// like buckets and tests can be set up and torn down without depending on
+// resource types this provider doesn't implement.
+func testAccRunscopeClient() *runscope.Client {
+	apiURL := os.Getenv("RUNSCOPE_API_URL")
+	if apiURL == "" {
+		apiURL = runscope.DefaultBaseURL
+	}
+
+	return runscope.NewClient(apiURL, os.Getenv("RUNSCOPE_ACCESS_TOKEN"))
+}
+
+func testAccCreateBucketFixture(t *testing.T) *runscope.Bucket {
+	client := testAccRunscopeClient()
+	bucket, err := client.CreateBucket(&runscope.Bucket{
+		Name: "tf-acc-test-env-import",
+		Team: &runscope.Team{UUID: os.Getenv("RUNSCOPE_TEAM_ID")},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create bucket fixture: %s", err)
+	}
+
+	return bucket
+}
+
+func testAccDeleteBucketFixture(t *testing.T, bucket *runscope.Bucket) {
+	client := testAccRunscopeClient()
+	if err := client.DeleteBucket(bucket); err != nil {
+		t.Logf("Failed to clean up bucket fixture %s: %s", bucket.Key, err)
+	}
+}
+
+func testAccCreateTestFixture(t *testing.T, bucket *runscope.Bucket) *runscope.Test {
+	client := testAccRunscopeClient()
+	test, err := client.CreateTest(&runscope.Test{Name: "tf-acc-test-test"}, bucket)
+	if err != nil {
+		t.Fatalf("Failed to create test fixture: %s", err)
+	}
+
+	return test
+}
+
+func testAccEnvironmentConfigA(bucketKey string) string {
+	return fmt.Sprintf(`
+resource "runscope_environment" "environment1" {
+  bucket_id = "%s"
+  name = "tf-acc-test-environment"
+}`, bucketKey)
+}
+
+func testAccTestEnvironmentConfig(bucketKey, testID string) string {
+	return fmt.Sprintf(`
+resource "runscope_environment" "environment1" {
+  bucket_id = "%s"
+  test_id = "%s"
+  name = "tf-acc-test-environment"
+}`, bucketKey, testID)
+}
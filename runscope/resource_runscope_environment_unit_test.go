@@ -0,0 +1,34 @@
+package runscope
+
+import "testing"
+
+func TestCreateEnvironmentFromResourceData_duplicateSensitiveKey(t *testing.T) {
+	d := resourceRunscopeEnvironment().Data(nil)
+	d.Set("name", "test")
+	d.Set("initial_variables", map[string]interface{}{"token": "abc"})
+	d.Set("sensitive_initial_variables", map[string]interface{}{"token": "xyz"})
+
+	_, err := createEnvironmentFromResourceData(d)
+	if err == nil {
+		t.Fatal("expected an error when a key appears in both initial_variables and sensitive_initial_variables")
+	}
+}
+
+func TestSplitSensitiveVariables(t *testing.T) {
+	variables := map[string]string{"token": "abc", "region": "us-west"}
+	declaredSensitive := map[string]interface{}{"token": "abc"}
+
+	nonSensitive, sensitive := splitSensitiveVariables(variables, declaredSensitive)
+
+	if _, ok := nonSensitive["token"]; ok {
+		t.Error("expected token to be excluded from the non-sensitive variables")
+	}
+
+	if sensitive["token"] != "abc" {
+		t.Errorf("expected sensitive variables to contain token, got: %#v", sensitive)
+	}
+
+	if nonSensitive["region"] != "us-west" {
+		t.Errorf("expected non-sensitive variables to contain region, got: %#v", nonSensitive)
+	}
+}
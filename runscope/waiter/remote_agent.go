@@ -0,0 +1,68 @@
+// Package waiter provides StateChangeConf-style helpers for polling
+// Runscope objects that converge asynchronously after an API call accepts
+// them, so resources can block a Create/Update until the remote side is
+// actually usable instead of racing subsequent operations against it.
+package waiter
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ewilde/go-runscope"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+const (
+	RemoteAgentStatusPending   = "pending"
+	RemoteAgentStatusConnected = "connected"
+)
+
+// RemoteAgentsConnected blocks until every uuid in uuids is reported as
+// connected among the remote agents belonging to teamUUID, or returns an
+// error if timeout elapses first.
+func RemoteAgentsConnected(
+	client *runscope.Client, teamUUID string, uuids []string, timeout, delay, minTimeout time.Duration) error {
+	if len(uuids) == 0 {
+		return nil
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{RemoteAgentStatusPending},
+		Target:     []string{RemoteAgentStatusConnected},
+		Refresh:    remoteAgentsRefreshFunc(client, teamUUID, uuids),
+		Timeout:    timeout,
+		Delay:      delay,
+		MinTimeout: minTimeout,
+	}
+
+	_, err := stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error waiting for remote agents to connect: %s", err)
+	}
+
+	return nil
+}
+
+func remoteAgentsRefreshFunc(client *runscope.Client, teamUUID string, uuids []string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		agents, err := client.ReadAgents(&runscope.Team{UUID: teamUUID})
+		if err != nil {
+			return nil, "", err
+		}
+
+		connected := map[string]bool{}
+		for _, agent := range agents {
+			if agent.ConnectionStatus == RemoteAgentStatusConnected {
+				connected[agent.UUID] = true
+			}
+		}
+
+		for _, uuid := range uuids {
+			if !connected[uuid] {
+				return agents, RemoteAgentStatusPending, nil
+			}
+		}
+
+		return agents, RemoteAgentStatusConnected, nil
+	}
+}